@@ -0,0 +1,238 @@
+package orderedmap
+
+import (
+	"fmt"
+	"hash/maphash"
+	"runtime"
+	"sync"
+
+	"github.com/LPX3F8/glist"
+)
+
+// cmEntry is the value a ConcurrentOrderedMap shard stores per key: the
+// value itself plus its node in the shared order list.
+type cmEntry[K comparable, V any] struct {
+	v V
+	e *glist.Element[K]
+}
+
+// cmShard guards one stripe of a ConcurrentOrderedMap's key space.
+type cmShard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	items map[K]*cmEntry[K, V]
+}
+
+// ConcurrentOrderedMap is a sharded counterpart of OrderedMap: the
+// key->value space is split into stripes, each guarded by its own
+// RWMutex, so Load/Has/Store on distinct keys don't contend with each
+// other the way they do on OrderedMap's single lock. Insertion order is
+// still global and is protected by a separate, finer-grained lock around
+// the shared glist.List, so traversal order matches OrderedMap's
+// semantics even though storage is sharded.
+type ConcurrentOrderedMap[K comparable, V any] struct {
+	shards []*cmShard[K, V]
+	seed   maphash.Seed
+
+	orderMu sync.Mutex
+	keys    *glist.List[K]
+}
+
+// ConcurrentOption configures NewConcurrent.
+type ConcurrentOption func(*concurrentConfig)
+
+type concurrentConfig struct {
+	shardCount int
+}
+
+// WithShardCount overrides the default shard count (runtime.GOMAXPROCS(0)*2).
+func WithShardCount(n int) ConcurrentOption {
+	return func(c *concurrentConfig) {
+		if n > 0 {
+			c.shardCount = n
+		}
+	}
+}
+
+// NewConcurrent returns a *ConcurrentOrderedMap[K, V].
+func NewConcurrent[K comparable, V any](opts ...ConcurrentOption) *ConcurrentOrderedMap[K, V] {
+	cfg := concurrentConfig{shardCount: runtime.GOMAXPROCS(0) * 2}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.shardCount < 1 {
+		cfg.shardCount = 1
+	}
+
+	shards := make([]*cmShard[K, V], cfg.shardCount)
+	for i := range shards {
+		shards[i] = &cmShard[K, V]{items: map[K]*cmEntry[K, V]{}}
+	}
+	return &ConcurrentOrderedMap[K, V]{
+		shards: shards,
+		seed:   maphash.MakeSeed(),
+		keys:   glist.New[K](),
+	}
+}
+
+// shardFor picks the shard owning k by hashing its "%v" representation,
+// which works for any comparable K without requiring it to implement a
+// dedicated hashing interface.
+func (m *ConcurrentOrderedMap[K, V]) shardFor(k K) *cmShard[K, V] {
+	var h maphash.Hash
+	h.SetSeed(m.seed)
+	_, _ = fmt.Fprintf(&h, "%v", k)
+	return m.shards[h.Sum64()%uint64(len(m.shards))]
+}
+
+// Store key-value pair.
+func (m *ConcurrentOrderedMap[K, V]) Store(k K, v V) *ConcurrentOrderedMap[K, V] {
+	shard := m.shardFor(k)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if _, ok := shard.items[k]; ok {
+		return m
+	}
+	m.orderMu.Lock()
+	e := m.keys.PushBack(k)
+	m.orderMu.Unlock()
+	shard.items[k] = &cmEntry[K, V]{v: v, e: e}
+	return m
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores and returns the given value.
+// The loaded result is true if the value was loaded, false if stored.
+func (m *ConcurrentOrderedMap[K, V]) LoadOrStore(k K, v V) (actual V, loaded bool) {
+	if actual, loaded = m.Load(k); loaded {
+		return actual, loaded
+	}
+	m.Store(k, v)
+	return v, loaded
+}
+
+// Has return key exists.
+func (m *ConcurrentOrderedMap[K, V]) Has(k K) bool {
+	_, ok := m.Load(k)
+	return ok
+}
+
+// Load returns the value stored in the map for a key, or zero-value if
+// no value is present. The ok result indicates whether value was found.
+func (m *ConcurrentOrderedMap[K, V]) Load(k K) (V, bool) {
+	shard := m.shardFor(k)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	if entry, ok := shard.items[k]; ok {
+		return entry.v, true
+	}
+	var v V
+	return v, false
+}
+
+// Delete removes key-value pair.
+func (m *ConcurrentOrderedMap[K, V]) Delete(k K) *ConcurrentOrderedMap[K, V] {
+	shard := m.shardFor(k)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, ok := shard.items[k]
+	if !ok {
+		return m
+	}
+	m.orderMu.Lock()
+	m.keys.Remove(entry.e)
+	m.orderMu.Unlock()
+	delete(shard.items, k)
+	return m
+}
+
+// Len return the map key size.
+func (m *ConcurrentOrderedMap[K, V]) Len() int {
+	m.orderMu.Lock()
+	defer m.orderMu.Unlock()
+	return m.keys.Len()
+}
+
+// Clear empty saved elements.
+func (m *ConcurrentOrderedMap[K, V]) Clear() *ConcurrentOrderedMap[K, V] {
+	for _, shard := range m.shards {
+		shard.mu.Lock()
+		shard.items = map[K]*cmEntry[K, V]{}
+		shard.mu.Unlock()
+	}
+	m.orderMu.Lock()
+	m.keys = glist.New[K]()
+	m.orderMu.Unlock()
+	return m
+}
+
+// Slice returns the elements slice, in insertion order.
+func (m *ConcurrentOrderedMap[K, V]) Slice(filters ...Filter[K, V]) []V {
+	return m.slice(Forward, filters...)
+}
+
+// Reverse returns the elements slice in reverse insertion order.
+func (m *ConcurrentOrderedMap[K, V]) Reverse(filters ...Filter[K, V]) []V {
+	return m.slice(Reverse, filters...)
+}
+
+func (m *ConcurrentOrderedMap[K, V]) slice(mode TravelMode, filters ...Filter[K, V]) []V {
+	slice := make([]V, 0, m.Len())
+	m.Travel(mode, func(idx int, key K, val V) bool {
+		slice = append(slice, val)
+		return false
+	}, filters...)
+	return slice
+}
+
+// TravelForward all items with visitor and filters.
+func (m *ConcurrentOrderedMap[K, V]) TravelForward(f Visitor[K, V], filters ...Filter[K, V]) {
+	m.Travel(Forward, f, filters...)
+}
+
+// TravelReverse all items with visitor and filters.
+func (m *ConcurrentOrderedMap[K, V]) TravelReverse(f Visitor[K, V], filters ...Filter[K, V]) {
+	m.Travel(Reverse, f, filters...)
+}
+
+// Travel items with custom travel mode. It copies the order list's keys
+// into a slice under orderMu, then walks that slice, so a concurrent
+// Delete removing the node currently being visited can never truncate
+// the traversal early the way chaining off a live *glist.Element would.
+// A slow Visitor still doesn't hold up concurrent Store/Delete, since
+// orderMu is only held for the initial copy.
+func (m *ConcurrentOrderedMap[K, V]) Travel(mode TravelMode, f Visitor[K, V], filters ...Filter[K, V]) {
+	m.orderMu.Lock()
+	keys := make([]K, 0, m.keys.Len())
+	for e := m.keys.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value)
+	}
+	m.orderMu.Unlock()
+
+	if mode == Reverse {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+
+	var idx int
+	for _, k := range keys {
+		v, ok := m.Load(k)
+		if !ok {
+			continue // deleted since the snapshot was taken
+		}
+
+		idx++
+		drop := false
+		for _, filter := range filters {
+			if drop = !filter(idx-1, k, v); drop {
+				break
+			}
+		}
+		if drop {
+			continue
+		}
+		if f(idx-1, k, v) {
+			break
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package orderedmap
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+func benchmarkConcurrentOrderedMap(b *testing.B, shards int) {
+	m := NewConcurrent[int, int](WithShardCount(shards))
+	rand.Seed(time.Now().UnixNano())
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			k := rand.Int()
+			v := rand.Int()
+			m.Store(k, v)
+			if nv, _ := m.Load(k); nv != v {
+				panic("NOT EQ!!")
+			}
+			m.Delete(k)
+			if m.Has(k) {
+				panic("KEY EXISTS")
+			}
+		}
+	})
+}
+
+func BenchmarkConcurrentOrderedMap_1Shard(b *testing.B) {
+	benchmarkConcurrentOrderedMap(b, 1)
+}
+
+func BenchmarkConcurrentOrderedMap_32Shards(b *testing.B) {
+	benchmarkConcurrentOrderedMap(b, 32)
+}
+
+func BenchmarkConcurrentOrderedMap_Store(b *testing.B) {
+	rand.Seed(time.Now().UnixNano())
+	b.ReportAllocs()
+
+	m := NewConcurrent[int, int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			k := rand.Int()
+			v := rand.Int()
+			m.Store(k, v)
+			if !m.Has(k) {
+				panic("KEY NOT SET")
+			}
+		}
+	})
+}
+
+func BenchmarkConcurrentOrderedMap_LoadOrStore(b *testing.B) {
+	rand.Seed(time.Now().UnixNano())
+	b.ReportAllocs()
+
+	m := NewConcurrent[int, int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			k := rand.Int()
+			v := rand.Int()
+			m.LoadOrStore(k, v)
+			if !m.Has(k) {
+				panic("KEY NOT SET")
+			}
+		}
+	})
+}
+
+// BenchmarkSyncMap_StoreLoadDelete and BenchmarkOrderedMap_StoreLoadDelete
+// run the same Store/Load/Delete cycle as BenchmarkConcurrentOrderedMap_1Shard
+// and _32Shards above, so the three can be compared directly to see how ConcurrentOrderedMap
+// scales against sync.Map and the plain (single-mutex) OrderedMap.
+func BenchmarkSyncMap_StoreLoadDelete(b *testing.B) {
+	rand.Seed(time.Now().UnixNano())
+	b.ReportAllocs()
+
+	var m sync.Map
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			k := rand.Int()
+			v := rand.Int()
+			m.Store(k, v)
+			if nv, _ := m.Load(k); nv != v {
+				panic("NOT EQ!!")
+			}
+			m.Delete(k)
+			if _, ok := m.Load(k); ok {
+				panic("KEY EXISTS")
+			}
+		}
+	})
+}
+
+func BenchmarkOrderedMap_StoreLoadDelete(b *testing.B) {
+	rand.Seed(time.Now().UnixNano())
+	b.ReportAllocs()
+
+	m := New[int, int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			k := rand.Int()
+			v := rand.Int()
+			m.Store(k, v)
+			if nv, _ := m.Load(k); nv != v {
+				panic("NOT EQ!!")
+			}
+			m.Delete(k)
+			if m.Has(k) {
+				panic("KEY EXISTS")
+			}
+		}
+	})
+}
+
+func BenchmarkConcurrentOrderedMap_Delete(b *testing.B) {
+	rand.Seed(time.Now().UnixNano())
+	b.ReportAllocs()
+
+	m := NewConcurrent[int, int]()
+	for i := 0; i < 1000000; i++ {
+		k := rand.Int()
+		v := rand.Int()
+		m.Store(k, v)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			var key int
+			m.TravelForward(func(idx int, k int, v int) bool {
+				key = k
+				return true
+			})
+			m.Delete(key)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package orderedmap
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentOrderedMap(t *testing.T) {
+	a := assert.New(t)
+	m := NewConcurrent[int, int](WithShardCount(4))
+
+	for i := 1; i <= 26; i++ {
+		m.Store(i, i)
+	}
+	a.Equal(26, m.Len())
+
+	var keys []int
+	m.TravelForward(func(idx int, k, v int) bool {
+		keys = append(keys, k)
+		return false
+	})
+	expected := make([]int, 26)
+	for i := range expected {
+		expected[i] = i + 1
+	}
+	a.Equal(expected, keys)
+
+	m.Delete(20)
+	v, ok := m.Load(20)
+	a.Equal(0, v)
+	a.False(ok)
+	a.Equal(25, m.Len())
+
+	s := m.Slice()
+	a.Equal(len(s), m.Len())
+	r := m.Reverse()
+	a.Equal(s[0], r[len(r)-1])
+
+	a.Equal(0, len(m.Clear().Slice()))
+}
+
+// TestConcurrentOrderedMap_TravelDuringConcurrentDelete guards against
+// Travel truncating early when a node it is currently visiting is
+// concurrently removed from the order list.
+func TestConcurrentOrderedMap_TravelDuringConcurrentDelete(t *testing.T) {
+	a := assert.New(t)
+	m := NewConcurrent[int, int]()
+	const n = 500
+	for i := 0; i < n; i++ {
+		m.Store(i, i)
+	}
+
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i += 2 {
+			m.Delete(i)
+		}
+	}()
+
+	var last int
+	var visited int
+	m.TravelForward(func(idx int, k, v int) bool {
+		visited++
+		last = k
+		return false
+	})
+	wg.Wait()
+
+	// Every key visited must have been live at some point; the traversal
+	// must not stop short of the list's tail just because a node it was
+	// visiting got concurrently removed.
+	a.LessOrEqual(visited, n)
+	a.GreaterOrEqual(last, n/2)
+}
+
+func TestConcurrentOrderedMap_Hammer(t *testing.T) {
+	m := NewConcurrent[int, int]()
+	wg := new(sync.WaitGroup)
+	wg.Add(10)
+	for i := 0; i < 10; i++ {
+		go func(i int) {
+			defer wg.Done()
+			// Each goroutine owns a disjoint key range, so its own
+			// Store->Load->Delete sequence can never be interleaved
+			// with another goroutine's Delete on the same key.
+			for j := 0; j < 1000; j++ {
+				k := i*1000 + j
+				m.Store(k, k)
+				if v, ok := m.Load(k); !ok || v != k {
+					t.Errorf("key %d: got (%d, %v)", k, v, ok)
+				}
+				m.Delete(k)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -2,6 +2,8 @@ package orderedmap
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"sync"
 
 	"github.com/LPX3F8/glist"
@@ -36,6 +38,31 @@ type OrderedMap[K comparable, V any] struct {
 	keys  *glist.List[K]
 	items map[K]*Item[K, V]
 	mu    *sync.RWMutex
+
+	subMu sync.Mutex
+	subs  map[*subscriber[K, V]]struct{}
+
+	dupPolicy DuplicateKeyPolicy
+}
+
+// DuplicateKeyPolicy controls how UnmarshalJSON handles a key that
+// appears more than once in the same JSON object.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyError fails UnmarshalJSON as soon as a repeated key is
+	// seen. This is the default.
+	DuplicateKeyError DuplicateKeyPolicy = iota
+	// DuplicateKeyLastWins keeps the key at its first position in
+	// insertion order but applies the value from its last occurrence.
+	DuplicateKeyLastWins
+)
+
+// SetDuplicateKeyPolicy sets how a later call to UnmarshalJSON should
+// handle duplicate object keys. The default is DuplicateKeyError.
+func (m *OrderedMap[K, V]) SetDuplicateKeyPolicy(p DuplicateKeyPolicy) *OrderedMap[K, V] {
+	m.dupPolicy = p
+	return m
 }
 
 // New returns a pointer of *OrderedMap[K, V]
@@ -50,9 +77,17 @@ func New[K comparable, V any]() *OrderedMap[K, V] {
 // Store key-value pair
 func (m *OrderedMap[K, V]) Store(k K, v V) *OrderedMap[K, V] {
 	m.mu.Lock()
-	defer m.mu.Unlock()
+	var idx int
+	stored := false
 	if _, ok := m.items[k]; !ok {
 		m.items[k] = newItem(k, v, m.keys.PushBack(k), m)
+		idx = m.keys.Len() - 1
+		stored = true
+	}
+	m.mu.Unlock()
+
+	if stored {
+		m.publish(Event[K, V]{Kind: EventStore, Key: k, NewValue: v, Index: idx})
 	}
 	return m
 }
@@ -90,11 +125,16 @@ func (m *OrderedMap[K, V]) Load(k K) (V, bool) {
 // Delete removes key-value pair
 func (m *OrderedMap[K, V]) Delete(k K) *OrderedMap[K, V] {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-	if ele, ok := m.items[k]; ok {
+	ele, ok := m.items[k]
+	if ok {
 		m.keys.Remove(ele.elements())
 		delete(m.items, k)
 	}
+	m.mu.Unlock()
+
+	if ok {
+		m.publish(Event[K, V]{Kind: EventDelete, Key: k, OldValue: ele.Value(), Index: -1})
+	}
 	return m
 }
 
@@ -207,9 +247,15 @@ func (m *OrderedMap[K, V]) Range(f func(key K, val V) bool) {
 // Clear empty saved elements
 func (m *OrderedMap[K, V]) Clear() *OrderedMap[K, V] {
 	m.mu.Lock()
-	defer m.mu.Unlock()
+	hadItems := m.keys.Len() > 0
 	m.keys = glist.New[K]()
 	m.items = map[K]*Item[K, V]{}
+	m.mu.Unlock()
+
+	if hadItems {
+		var zeroKey K
+		m.publish(Event[K, V]{Kind: EventClear, Key: zeroKey, Index: -1})
+	}
 	return m
 }
 
@@ -240,3 +286,87 @@ func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
 	buf.WriteRune('}')
 	return buf.Bytes(), nil
 }
+
+// UnmarshalJSON implement the json.Unmarshaler interface, making OrderedMap
+// a round-trip counterpart of MarshalJSON. It walks the top-level object
+// token-by-token with a streaming json.Decoder instead of going through
+// map[string]interface{}, so the original source order is preserved and
+// Store is called for each key in the order it appears. Nested OrderedMap
+// values recurse automatically since they implement json.Unmarshaler too.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("orderedmap: UnmarshalJSON: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedmap: UnmarshalJSON: expected JSON object, got %v", tok)
+	}
+
+	if m.keys == nil {
+		m.keys = glist.New[K]()
+	}
+	if m.items == nil {
+		m.items = map[K]*Item[K, V]{}
+	}
+	if m.mu == nil {
+		m.mu = new(sync.RWMutex)
+	}
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("orderedmap: UnmarshalJSON: %w", err)
+		}
+		keyStr, ok := keyTok.(string)
+		if !ok {
+			return fmt.Errorf("orderedmap: UnmarshalJSON: expected string key, got %v", keyTok)
+		}
+
+		var key K
+		if err = unmarshalJSONKey(keyStr, &key); err != nil {
+			return fmt.Errorf("orderedmap: UnmarshalJSON: decode key %q: %w", keyStr, err)
+		}
+		existing, exists := m.items[key]
+		if exists && m.dupPolicy == DuplicateKeyError {
+			return fmt.Errorf("orderedmap: UnmarshalJSON: duplicate key %q", keyStr)
+		}
+
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			return fmt.Errorf("orderedmap: UnmarshalJSON: decode value for key %q: %w", keyStr, err)
+		}
+		var val V
+		if err = json.Unmarshal(raw, &val); err != nil {
+			return fmt.Errorf("orderedmap: UnmarshalJSON: decode value for key %q: %w", keyStr, err)
+		}
+
+		if exists {
+			// DuplicateKeyLastWins: keep the key's original position, adopt the new value.
+			existing.v = val
+			continue
+		}
+		m.Store(key, val)
+	}
+
+	if tok, err = dec.Token(); err != nil {
+		return fmt.Errorf("orderedmap: UnmarshalJSON: %w", err)
+	} else if delim, ok := tok.(json.Delim); !ok || delim != '}' {
+		return fmt.Errorf("orderedmap: UnmarshalJSON: expected end of object, got %v", tok)
+	}
+	return nil
+}
+
+// unmarshalJSONKey decodes a JSON object key (already unquoted by the
+// tokenizer) into K by re-quoting it and delegating to encoding/json, so
+// string keys, encoding.TextUnmarshaler keys and the like all work the
+// same way they would for a top-level JSON string value.
+func unmarshalJSONKey[K comparable](raw string, key *K) error {
+	quoted, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(quoted, key)
+}
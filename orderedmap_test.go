@@ -105,6 +105,39 @@ func TestOrderedMap_MarshalJSON(t *testing.T) {
 	a.Error(err)
 }
 
+func TestOrderedMap_UnmarshalJSON(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+	a.NoError(json.Unmarshal([]byte(`{"key3":3,"key1":1,"key2":2}`), m))
+	a.Equal([]int{3, 1, 2}, m.Slice())
+
+	var keys []string
+	m.TravelForward(func(idx int, k string, v int) bool {
+		keys = append(keys, k)
+		return false
+	})
+	a.Equal([]string{"key3", "key1", "key2"}, keys)
+
+	m2 := New[string, int]()
+	a.Error(json.Unmarshal([]byte(`[1,2,3]`), m2))
+
+	m3 := New[string, int]()
+	a.Error(json.Unmarshal([]byte(`{"key1":1,"key1":2}`), m3))
+
+	m4 := New[string, int]().SetDuplicateKeyPolicy(DuplicateKeyLastWins)
+	a.NoError(json.Unmarshal([]byte(`{"key1":1,"key2":2,"key1":3}`), m4))
+	a.Equal([]int{3, 2}, m4.Slice())
+	v, ok := m4.Load("key1")
+	a.True(ok)
+	a.Equal(3, v)
+
+	nested := New[string, *OrderedMap[string, int]]()
+	a.NoError(json.Unmarshal([]byte(`{"outer":{"a":1,"b":2}}`), nested))
+	inner, ok := nested.Load("outer")
+	a.True(ok)
+	a.Equal([]int{1, 2}, inner.Slice())
+}
+
 func TestOrderedMap_Example(t *testing.T) {
 	om := New[string, int]()
 	om.Store("k1", 1).Store("k2", 2).Store("k3", 3).
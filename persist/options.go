@@ -0,0 +1,36 @@
+package persist
+
+import "time"
+
+// SyncPolicy controls how aggressively a PersistentOrderedMap fsyncs its
+// append-only log after a mutation.
+type SyncPolicy int
+
+const (
+	// SyncAlways fsyncs after every mutation. Safest, slowest.
+	SyncAlways SyncPolicy = iota
+	// SyncInterval fsyncs on a fixed schedule; see Options.SyncInterval.
+	SyncInterval
+	// SyncNever leaves fsyncing to the OS page cache flush.
+	SyncNever
+)
+
+// Options configures a PersistentOrderedMap returned by Open.
+type Options struct {
+	// Sync selects the fsync policy. Defaults to SyncAlways.
+	Sync SyncPolicy
+	// SyncInterval is the fsync period used when Sync is SyncInterval.
+	// Defaults to one second.
+	SyncInterval time.Duration
+	// CompactThreshold is the log size, in bytes, at which a background
+	// goroutine triggers Compact automatically. Zero disables automatic
+	// compaction.
+	CompactThreshold int64
+}
+
+func (o Options) withDefaults() Options {
+	if o.SyncInterval <= 0 {
+		o.SyncInterval = time.Second
+	}
+	return o
+}
@@ -0,0 +1,405 @@
+// Package persist wraps orderedmap.OrderedMap with a durable append-only
+// log and periodic snapshots, so insertion order and contents survive
+// process restarts.
+package persist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/LPX3F8/orderedmap"
+)
+
+const (
+	snapshotFileName = "snapshot.json"
+	logFileName      = "log.bin"
+)
+
+// PersistentOrderedMap wraps an orderedmap.OrderedMap[K, V], intercepting
+// mutations to append them to a durable log before applying them in
+// memory. Compact collapses the log into a fresh snapshot.
+type PersistentOrderedMap[K comparable, V any] struct {
+	mu   sync.Mutex
+	m    *orderedmap.OrderedMap[K, V]
+	dir  string
+	opts Options
+
+	logFile *os.File
+	logSize int64
+
+	closeOnce sync.Once
+	stopSync  chan struct{}
+	wg        sync.WaitGroup
+}
+
+// Open loads path (creating it if necessary), replaying the latest
+// snapshot plus any trailing log records to rebuild the map's contents
+// and insertion order, then returns a PersistentOrderedMap ready for use.
+func Open[K comparable, V any](path string, opts Options) (*PersistentOrderedMap[K, V], error) {
+	opts = opts.withDefaults()
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, fmt.Errorf("persist: open: %w", err)
+	}
+
+	p := &PersistentOrderedMap[K, V]{
+		m:        orderedmap.New[K, V](),
+		dir:      path,
+		opts:     opts,
+		stopSync: make(chan struct{}),
+	}
+
+	if err := p.loadSnapshot(); err != nil {
+		return nil, fmt.Errorf("persist: open: %w", err)
+	}
+	if err := p.replayLog(); err != nil {
+		return nil, fmt.Errorf("persist: open: %w", err)
+	}
+
+	f, err := os.OpenFile(p.logPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("persist: open: %w", err)
+	}
+	p.logFile = f
+	if info, err := f.Stat(); err == nil {
+		p.logSize = info.Size()
+	}
+
+	if opts.Sync == SyncInterval {
+		p.wg.Add(1)
+		go p.syncLoop()
+	}
+	return p, nil
+}
+
+func (p *PersistentOrderedMap[K, V]) snapshotPath() string {
+	return filepath.Join(p.dir, snapshotFileName)
+}
+
+func (p *PersistentOrderedMap[K, V]) logPath() string {
+	return filepath.Join(p.dir, logFileName)
+}
+
+func (p *PersistentOrderedMap[K, V]) loadSnapshot() error {
+	f, err := os.Open(p.snapshotPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewDecoder(f).Decode(p.m)
+}
+
+// replayLog applies every record in the log to p.m in order. If the
+// trailing record is incomplete or fails its checksum — what an unclean
+// shutdown mid-write produces — replay stops there and the log is
+// truncated back to the last good record, rather than failing Open for
+// the whole store.
+func (p *PersistentOrderedMap[K, V]) replayLog() error {
+	f, err := os.Open(p.logPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var consumed int64
+	for {
+		rec, n, err := readRecord(f)
+		if err == io.EOF {
+			return nil
+		}
+		if errors.Is(err, errTornRecord) {
+			return os.Truncate(p.logPath(), consumed)
+		}
+		if err != nil {
+			return err
+		}
+		consumed += n
+		if err = p.apply(rec); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *PersistentOrderedMap[K, V]) apply(rec record) error {
+	var key K
+	if len(rec.Key) > 0 {
+		if err := json.Unmarshal(rec.Key, &key); err != nil {
+			return fmt.Errorf("persist: replay: decode key: %w", err)
+		}
+	}
+	switch rec.Op {
+	case opStore:
+		var val V
+		if err := json.Unmarshal(rec.Value, &val); err != nil {
+			return fmt.Errorf("persist: replay: decode value: %w", err)
+		}
+		p.m.Store(key, val)
+	case opDelete:
+		p.m.Delete(key)
+	case opMoveToFront:
+		p.m.MoveToFront(key)
+	case opMoveToBack:
+		p.m.MoveToBack(key)
+	case opMoveBefore, opMoveAfter:
+		var mark K
+		if err := json.Unmarshal(rec.Mark, &mark); err != nil {
+			return fmt.Errorf("persist: replay: decode mark: %w", err)
+		}
+		if rec.Op == opMoveBefore {
+			p.m.MoveBefore(key, mark)
+		} else {
+			p.m.MoveAfter(key, mark)
+		}
+	default:
+		return fmt.Errorf("persist: replay: unknown op %d", rec.Op)
+	}
+	return nil
+}
+
+// Store durably appends k=v to the log and applies it to the in-memory
+// map as one atomic step under p.mu, so concurrent Store/Delete calls
+// can never land in the log in a different order than they're applied
+// to the in-memory map — a restart always replays the order that was
+// actually live.
+func (p *PersistentOrderedMap[K, V]) Store(k K, v V) error {
+	keyBytes, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("persist: store: %w", err)
+	}
+	valBytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("persist: store: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err = p.appendLocked(record{Op: opStore, Key: keyBytes, Value: valBytes}); err != nil {
+		return err
+	}
+	p.m.Store(k, v)
+	return nil
+}
+
+// Delete durably appends the removal of k to the log and applies it to
+// the in-memory map as one atomic step under p.mu; see Store for why
+// that matters.
+func (p *PersistentOrderedMap[K, V]) Delete(k K) error {
+	keyBytes, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("persist: delete: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err = p.appendLocked(record{Op: opDelete, Key: keyBytes}); err != nil {
+		return err
+	}
+	p.m.Delete(k)
+	return nil
+}
+
+// MoveToFront durably appends a move-to-front of k to the log and
+// applies it to the in-memory map as one atomic step under p.mu; see
+// Store for why that matters. It is a no-op if k is not present.
+func (p *PersistentOrderedMap[K, V]) MoveToFront(k K) error {
+	keyBytes, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("persist: move to front: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.m.Has(k) {
+		return nil
+	}
+	if err = p.appendLocked(record{Op: opMoveToFront, Key: keyBytes}); err != nil {
+		return err
+	}
+	p.m.MoveToFront(k)
+	return nil
+}
+
+// MoveToBack durably appends a move-to-back of k to the log and applies
+// it to the in-memory map as one atomic step under p.mu; see Store for
+// why that matters. It is a no-op if k is not present.
+func (p *PersistentOrderedMap[K, V]) MoveToBack(k K) error {
+	keyBytes, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("persist: move to back: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.m.Has(k) {
+		return nil
+	}
+	if err = p.appendLocked(record{Op: opMoveToBack, Key: keyBytes}); err != nil {
+		return err
+	}
+	p.m.MoveToBack(k)
+	return nil
+}
+
+// MoveBefore durably appends moving k to directly before mark to the log
+// and applies it to the in-memory map as one atomic step under p.mu; see
+// Store for why that matters. It is a no-op if either key is missing.
+func (p *PersistentOrderedMap[K, V]) MoveBefore(k, mark K) error {
+	keyBytes, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("persist: move before: %w", err)
+	}
+	markBytes, err := json.Marshal(mark)
+	if err != nil {
+		return fmt.Errorf("persist: move before: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.m.Has(k) || !p.m.Has(mark) {
+		return nil
+	}
+	if err = p.appendLocked(record{Op: opMoveBefore, Key: keyBytes, Mark: markBytes}); err != nil {
+		return err
+	}
+	p.m.MoveBefore(k, mark)
+	return nil
+}
+
+// MoveAfter durably appends moving k to directly after mark to the log
+// and applies it to the in-memory map as one atomic step under p.mu; see
+// Store for why that matters. It is a no-op if either key is missing.
+func (p *PersistentOrderedMap[K, V]) MoveAfter(k, mark K) error {
+	keyBytes, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Errorf("persist: move after: %w", err)
+	}
+	markBytes, err := json.Marshal(mark)
+	if err != nil {
+		return fmt.Errorf("persist: move after: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.m.Has(k) || !p.m.Has(mark) {
+		return nil
+	}
+	if err = p.appendLocked(record{Op: opMoveAfter, Key: keyBytes, Mark: markBytes}); err != nil {
+		return err
+	}
+	p.m.MoveAfter(k, mark)
+	return nil
+}
+
+// Load returns the value stored for k, mirroring orderedmap.OrderedMap.Load.
+func (p *PersistentOrderedMap[K, V]) Load(k K) (V, bool) {
+	return p.m.Load(k)
+}
+
+// Len returns the number of entries, mirroring orderedmap.OrderedMap.Len.
+func (p *PersistentOrderedMap[K, V]) Len() int {
+	return p.m.Len()
+}
+
+// appendLocked writes rec to the log. Callers must hold p.mu.
+func (p *PersistentOrderedMap[K, V]) appendLocked(rec record) error {
+	cw := &countingWriter{w: p.logFile}
+	if err := writeRecord(cw, rec); err != nil {
+		return fmt.Errorf("persist: append: %w", err)
+	}
+	p.logSize += cw.n
+
+	if p.opts.Sync == SyncAlways {
+		if err := p.logFile.Sync(); err != nil {
+			return fmt.Errorf("persist: append: fsync: %w", err)
+		}
+	}
+	if p.opts.CompactThreshold > 0 && p.logSize >= p.opts.CompactThreshold {
+		go func() { _ = p.Compact() }()
+	}
+	return nil
+}
+
+// Compact atomically writes a fresh snapshot from the current in-memory
+// state and truncates the log, so restart time stops growing with the
+// full mutation history.
+func (p *PersistentOrderedMap[K, V]) Compact() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tmp := p.snapshotPath() + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+	if err = json.NewEncoder(f).Encode(p.m); err != nil {
+		f.Close()
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+	if err = os.Rename(tmp, p.snapshotPath()); err != nil {
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+
+	if err = p.logFile.Truncate(0); err != nil {
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+	if _, err = p.logFile.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("persist: compact: %w", err)
+	}
+	p.logSize = 0
+	return nil
+}
+
+func (p *PersistentOrderedMap[K, V]) syncLoop() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.opts.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.mu.Lock()
+			_ = p.logFile.Sync()
+			p.mu.Unlock()
+		case <-p.stopSync:
+			return
+		}
+	}
+}
+
+// Close stops the background sync loop, if any, and closes the log file.
+func (p *PersistentOrderedMap[K, V]) Close() error {
+	p.closeOnce.Do(func() { close(p.stopSync) })
+	p.wg.Wait()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.logFile.Close()
+}
+
+// countingWriter tracks the number of bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,82 @@
+package persist
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// opKind identifies the mutation a log record represents.
+type opKind uint8
+
+const (
+	opStore opKind = iota + 1
+	opDelete
+	opMoveToFront
+	opMoveToBack
+	opMoveBefore
+	opMoveAfter
+)
+
+// record is one append-only log entry: an op plus the key/value/mark it
+// carries, encoded as length-prefixed JSON guarded by a CRC32 checksum.
+type record struct {
+	Op    opKind          `json:"op"`
+	Key   json.RawMessage `json:"key,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Mark  json.RawMessage `json:"mark,omitempty"`
+}
+
+// errTornRecord wraps a log record that is incomplete or fails its CRC,
+// as an unclean shutdown mid-write would produce. Replay stops at the
+// first one instead of failing the whole Open.
+var errTornRecord = errors.New("persist: torn trailing record")
+
+// writeRecord appends r to w as [size uint32][crc32 uint32][json body].
+func writeRecord(w io.Writer, r record) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("persist: encode record: %w", err)
+	}
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[0:4], uint32(len(body)))
+	binary.BigEndian.PutUint32(header[4:8], crc32.ChecksumIEEE(body))
+	if _, err = w.Write(header[:]); err != nil {
+		return fmt.Errorf("persist: write record header: %w", err)
+	}
+	if _, err = w.Write(body); err != nil {
+		return fmt.Errorf("persist: write record body: %w", err)
+	}
+	return nil
+}
+
+// readRecord reads one record from r, returning the number of bytes it
+// consumed. It returns io.EOF (with n == 0) once the log is cleanly
+// exhausted, or an error wrapping errTornRecord if what follows looks
+// like a partially written trailing record rather than a clean boundary.
+func readRecord(r io.Reader) (rec record, n int64, err error) {
+	var header [8]byte
+	if _, err = io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return rec, 0, io.EOF
+		}
+		return rec, 0, fmt.Errorf("%w: %v", errTornRecord, err)
+	}
+	size := binary.BigEndian.Uint32(header[0:4])
+	wantCRC := binary.BigEndian.Uint32(header[4:8])
+
+	body := make([]byte, size)
+	if _, err = io.ReadFull(r, body); err != nil {
+		return rec, 0, fmt.Errorf("%w: %v", errTornRecord, err)
+	}
+	if gotCRC := crc32.ChecksumIEEE(body); gotCRC != wantCRC {
+		return rec, 0, fmt.Errorf("%w: checksum mismatch got %x, want %x", errTornRecord, gotCRC, wantCRC)
+	}
+	if err = json.Unmarshal(body, &rec); err != nil {
+		return rec, 0, fmt.Errorf("persist: decode record: %w", err)
+	}
+	return rec, int64(len(header) + len(body)), nil
+}
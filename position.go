@@ -0,0 +1,172 @@
+package orderedmap
+
+import (
+	"errors"
+
+	"github.com/LPX3F8/glist"
+)
+
+// ErrOutOfRange is returned by positional operations when pos falls
+// outside the valid range for the map's current size.
+var ErrOutOfRange = errors.New("orderedmap: index out of range")
+
+// InsertAt inserts a new key-value pair at pos, shifting the element
+// currently at pos (and everything after it) back by one. Negative
+// positions count from the back, so -1 inserts before the current last
+// element. Valid positions are [-Len(), Len()]; anything else returns
+// ErrOutOfRange. If k already exists, InsertAt is a no-op.
+func (m *OrderedMap[K, V]) InsertAt(pos int, k K, v V) error {
+	m.mu.Lock()
+	if _, ok := m.items[k]; ok {
+		m.mu.Unlock()
+		return nil
+	}
+
+	n := m.keys.Len()
+	if pos < 0 {
+		pos += n
+	}
+	if pos < 0 || pos > n {
+		m.mu.Unlock()
+		return ErrOutOfRange
+	}
+
+	if pos == n {
+		m.items[k] = newItem(k, v, m.keys.PushBack(k), m)
+		m.mu.Unlock()
+		m.publish(Event[K, V]{Kind: EventStore, Key: k, NewValue: v, Index: pos})
+		return nil
+	}
+	mark, err := m.elementAt(pos)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	m.items[k] = newItem(k, v, m.keys.InsertBefore(k, mark), m)
+	m.mu.Unlock()
+
+	m.publish(Event[K, V]{Kind: EventStore, Key: k, NewValue: v, Index: pos})
+	return nil
+}
+
+// MoveToFront moves k to the front of the map. It is a no-op if k is not present.
+func (m *OrderedMap[K, V]) MoveToFront(k K) {
+	m.mu.Lock()
+	item, ok := m.items[k]
+	if ok {
+		m.keys.MoveToFront(item.elements())
+	}
+	m.mu.Unlock()
+
+	if ok {
+		m.publish(Event[K, V]{Kind: EventMove, Key: k, NewValue: item.Value(), Index: 0})
+	}
+}
+
+// MoveToBack moves k to the back of the map. It is a no-op if k is not present.
+func (m *OrderedMap[K, V]) MoveToBack(k K) {
+	m.mu.Lock()
+	item, ok := m.items[k]
+	var idx int
+	if ok {
+		m.keys.MoveToBack(item.elements())
+		idx = m.keys.Len() - 1
+	}
+	m.mu.Unlock()
+
+	if ok {
+		m.publish(Event[K, V]{Kind: EventMove, Key: k, NewValue: item.Value(), Index: idx})
+	}
+}
+
+// MoveBefore moves k so that it sits directly before mark. It is a no-op
+// if either key is missing, or if k and mark are the same key.
+func (m *OrderedMap[K, V]) MoveBefore(k, mark K) {
+	m.mu.Lock()
+	item, ok := m.items[k]
+	if ok {
+		if markItem, markOK := m.items[mark]; markOK && mark != k {
+			m.keys.MoveBefore(item.elements(), markItem.elements())
+		} else {
+			ok = false
+		}
+	}
+	m.mu.Unlock()
+
+	if ok {
+		m.publish(Event[K, V]{Kind: EventMove, Key: k, NewValue: item.Value(), Index: m.IndexOf(k)})
+	}
+}
+
+// MoveAfter moves k so that it sits directly after mark. It is a no-op
+// if either key is missing, or if k and mark are the same key.
+func (m *OrderedMap[K, V]) MoveAfter(k, mark K) {
+	m.mu.Lock()
+	item, ok := m.items[k]
+	if ok {
+		if markItem, markOK := m.items[mark]; markOK && mark != k {
+			m.keys.MoveAfter(item.elements(), markItem.elements())
+		} else {
+			ok = false
+		}
+	}
+	m.mu.Unlock()
+
+	if ok {
+		m.publish(Event[K, V]{Kind: EventMove, Key: k, NewValue: item.Value(), Index: m.IndexOf(k)})
+	}
+}
+
+// GetAt returns the Item at pos in insertion order. Negative positions
+// count from the back, so -1 returns the last element. The ok result is
+// false if pos is out of range.
+func (m *OrderedMap[K, V]) GetAt(pos int) (*Item[K, V], bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if pos < 0 {
+		pos += m.keys.Len()
+	}
+	e, err := m.elementAt(pos)
+	if err != nil {
+		return nil, false
+	}
+	return m.items[e.Value], true
+}
+
+// IndexOf returns the position of k in insertion order, or -1 if k is not present.
+func (m *OrderedMap[K, V]) IndexOf(k K) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if _, ok := m.items[k]; !ok {
+		return -1
+	}
+	idx := 0
+	for e := m.keys.Front(); e != nil; e = e.Next() {
+		if e.Value == k {
+			return idx
+		}
+		idx++
+	}
+	return -1
+}
+
+// elementAt returns the list element at pos, walking from whichever end
+// is closer. Callers must hold m.mu and pos is expected in [0, Len()).
+func (m *OrderedMap[K, V]) elementAt(pos int) (*glist.Element[K], error) {
+	n := m.keys.Len()
+	if pos < 0 || pos >= n {
+		return nil, ErrOutOfRange
+	}
+	if pos <= n/2 {
+		e := m.keys.Front()
+		for i := 0; i < pos; i++ {
+			e = e.Next()
+		}
+		return e, nil
+	}
+	e := m.keys.Back()
+	for i := n - 1; i > pos; i-- {
+		e = e.Prev()
+	}
+	return e, nil
+}
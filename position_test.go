@@ -0,0 +1,84 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap_InsertAt(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+	m.Store("a", 1).Store("b", 2).Store("c", 3)
+
+	a.NoError(m.InsertAt(1, "x", 10))
+	a.Equal([]string{"a", "x", "b", "c"}, collectKeys(m))
+
+	a.NoError(m.InsertAt(-1, "y", 20))
+	a.Equal([]string{"a", "x", "b", "y", "c"}, collectKeys(m))
+
+	a.NoError(m.InsertAt(m.Len(), "z", 30))
+	a.Equal([]string{"a", "x", "b", "y", "c", "z"}, collectKeys(m))
+
+	a.ErrorIs(m.InsertAt(100, "oops", 0), ErrOutOfRange)
+	a.ErrorIs(m.InsertAt(-100, "oops", 0), ErrOutOfRange)
+
+	a.NoError(m.InsertAt(0, "a", 999)) // no-op: key already exists
+	v, _ := m.Load("a")
+	a.Equal(1, v)
+}
+
+func TestOrderedMap_MoveOps(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+	m.Store("a", 1).Store("b", 2).Store("c", 3).Store("d", 4)
+
+	m.MoveToFront("c")
+	a.Equal([]string{"c", "a", "b", "d"}, collectKeys(m))
+
+	m.MoveToBack("a")
+	a.Equal([]string{"c", "b", "d", "a"}, collectKeys(m))
+
+	m.MoveBefore("a", "c")
+	a.Equal([]string{"a", "c", "b", "d"}, collectKeys(m))
+
+	m.MoveAfter("b", "a")
+	a.Equal([]string{"a", "b", "c", "d"}, collectKeys(m))
+
+	// missing keys are no-ops
+	m.MoveToFront("missing")
+	m.MoveBefore("missing", "a")
+	m.MoveAfter("a", "missing")
+	a.Equal([]string{"a", "b", "c", "d"}, collectKeys(m))
+}
+
+func TestOrderedMap_GetAtIndexOf(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+	m.Store("a", 1).Store("b", 2).Store("c", 3)
+
+	item, ok := m.GetAt(1)
+	a.True(ok)
+	a.Equal("b", item.Key())
+	a.Equal(2, item.Value())
+
+	item, ok = m.GetAt(-1)
+	a.True(ok)
+	a.Equal("c", item.Key())
+
+	_, ok = m.GetAt(3)
+	a.False(ok)
+
+	a.Equal(0, m.IndexOf("a"))
+	a.Equal(2, m.IndexOf("c"))
+	a.Equal(-1, m.IndexOf("missing"))
+}
+
+func collectKeys(m *OrderedMap[string, int]) []string {
+	var keys []string
+	m.TravelForward(func(idx int, k string, v int) bool {
+		keys = append(keys, k)
+		return false
+	})
+	return keys
+}
@@ -0,0 +1,168 @@
+package orderedmap
+
+import "math/rand"
+
+const (
+	skipListMaxLevel = 32
+	skipListP        = 0.25
+)
+
+// skipNode is one node of a skipList, holding the Item it represents and
+// a forward pointer per level it participates in.
+type skipNode[K comparable, V any] struct {
+	item Item[K, V]
+	next []*skipNode[K, V]
+}
+
+// skipList is a probabilistic skip list ordering Item[K, V] by a
+// Comparator, backing SortedOrderedMap's secondary index.
+type skipList[K comparable, V any] struct {
+	cmp   Comparator[K, V]
+	head  *skipNode[K, V]
+	level int
+	size  int
+}
+
+func newSkipList[K comparable, V any](cmp Comparator[K, V]) *skipList[K, V] {
+	return &skipList[K, V]{
+		cmp:   cmp,
+		head:  &skipNode[K, V]{next: make([]*skipNode[K, V], skipListMaxLevel)},
+		level: 1,
+	}
+}
+
+func randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && rand.Float64() < skipListP {
+		level++
+	}
+	return level
+}
+
+// search returns, for every level, the rightmost node sorting strictly
+// before target, so update[0].next[0] is the first node that may equal target.
+func (s *skipList[K, V]) search(target Item[K, V]) []*skipNode[K, V] {
+	update := make([]*skipNode[K, V], skipListMaxLevel)
+	cur := s.head
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for cur.next[lvl] != nil && s.cmp(cur.next[lvl].item, target) < 0 {
+			cur = cur.next[lvl]
+		}
+		update[lvl] = cur
+	}
+	return update
+}
+
+func (s *skipList[K, V]) insert(item Item[K, V]) {
+	update := s.search(item)
+	lvl := randomLevel()
+	if lvl > s.level {
+		for i := s.level; i < lvl; i++ {
+			update[i] = s.head
+		}
+		s.level = lvl
+	}
+	node := &skipNode[K, V]{item: item, next: make([]*skipNode[K, V], lvl)}
+	for i := 0; i < lvl; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	s.size++
+}
+
+// delete removes the node for k. item must compare equal to the node's
+// stored item under the comparator so the descent lands on the right
+// bucket of ties, if any.
+func (s *skipList[K, V]) delete(k K, item Item[K, V]) {
+	update := s.search(item)
+	cur := update[0].next[0]
+	for cur != nil && cur.item.Key() != k && s.cmp(cur.item, item) == 0 {
+		cur = cur.next[0]
+	}
+	if cur == nil || cur.item.Key() != k {
+		return
+	}
+	if update[0].next[0] != cur {
+		update = s.search(cur.item)
+	}
+	for i := 0; i < len(cur.next); i++ {
+		if update[i].next[i] == cur {
+			update[i].next[i] = cur.next[i]
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+}
+
+func (s *skipList[K, V]) min() *Item[K, V] {
+	if s.head.next[0] == nil {
+		return nil
+	}
+	it := s.head.next[0].item
+	return &it
+}
+
+func (s *skipList[K, V]) max() *Item[K, V] {
+	cur := s.head
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for cur.next[lvl] != nil {
+			cur = cur.next[lvl]
+		}
+	}
+	if cur == s.head {
+		return nil
+	}
+	it := cur.item
+	return &it
+}
+
+// ceiling returns the first item that is >= target under the comparator.
+func (s *skipList[K, V]) ceiling(target Item[K, V]) *Item[K, V] {
+	cur := s.search(target)[0].next[0]
+	if cur == nil {
+		return nil
+	}
+	it := cur.item
+	return &it
+}
+
+// floor returns the last item that is <= target under the comparator.
+func (s *skipList[K, V]) floor(target Item[K, V]) *Item[K, V] {
+	cur := s.head
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for cur.next[lvl] != nil && s.cmp(cur.next[lvl].item, target) <= 0 {
+			cur = cur.next[lvl]
+		}
+	}
+	if cur == s.head {
+		return nil
+	}
+	it := cur.item
+	return &it
+}
+
+// rangeVisit calls visitor for every item in [lo, hi], ascending, stopping early if visitor returns true.
+func (s *skipList[K, V]) rangeVisit(lo, hi Item[K, V], visitor func(Item[K, V]) bool) {
+	cur := s.search(lo)[0].next[0]
+	for ; cur != nil && s.cmp(cur.item, hi) <= 0; cur = cur.next[0] {
+		if visitor(cur.item) {
+			return
+		}
+	}
+}
+
+// collect returns every item in the list, ascending or descending per mode.
+func (s *skipList[K, V]) collect(mode TravelMode) []Item[K, V] {
+	items := make([]Item[K, V], 0, s.size)
+	for cur := s.head.next[0]; cur != nil; cur = cur.next[0] {
+		items = append(items, cur.item)
+	}
+	if mode == Reverse {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+	return items
+}
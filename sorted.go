@@ -0,0 +1,232 @@
+package orderedmap
+
+import "github.com/LPX3F8/glist"
+
+// Comparator orders two items for use by SortedOrderedMap's secondary
+// index. It must return a negative number if a sorts before b, zero if
+// they are equivalent, and a positive number if a sorts after b.
+type Comparator[K comparable, V any] func(a, b Item[K, V]) int
+
+// SortedOrderedMap layers a comparator-ordered secondary index on top of
+// an OrderedMap: the embedded OrderedMap still gives insertion-order
+// traversal, while Min/Max/Ceiling/Floor/RangeByOrder/TravelSorted walk
+// the map in comparator order. The secondary index is a skip list, so
+// Store/Delete stay O(log n) on average. Both views share the embedded
+// OrderedMap's RWMutex, so they stay consistent under concurrent use.
+//
+// Every mutating method that can change set membership (Store, Delete,
+// LoadOrStore, Clear, InsertAt, UnmarshalJSON) is overridden here to keep the skip list
+// in sync — Go embedding has no virtual dispatch, so without an explicit
+// override the embedded OrderedMap's version would run instead and never
+// touch m.list. MoveToFront/MoveToBack/MoveBefore/MoveAfter are also
+// overridden for clarity, even though they only reorder insertion order
+// and never need to touch the comparator-ordered index.
+//
+// The Store, Delete, LoadOrStore, Clear and InsertAt overrides publish no
+// Events, so a Subscribe on a SortedOrderedMap receives nothing for those
+// mutations; the Move* methods and UnmarshalJSON do publish.
+//
+// Ceiling, Floor and RangeByOrder look up their key argument in the map
+// to build the probe compared against the index; for a key that isn't
+// present they probe with a zero-value V, so a Comparator that also
+// orders by V may behave unexpectedly for missing keys.
+type SortedOrderedMap[K comparable, V any] struct {
+	*OrderedMap[K, V]
+	cmp  Comparator[K, V]
+	list *skipList[K, V]
+}
+
+// NewSorted returns a SortedOrderedMap whose secondary index is ordered by cmp.
+func NewSorted[K comparable, V any](cmp Comparator[K, V]) *SortedOrderedMap[K, V] {
+	return &SortedOrderedMap[K, V]{
+		OrderedMap: New[K, V](),
+		cmp:        cmp,
+		list:       newSkipList[K, V](cmp),
+	}
+}
+
+// Store inserts k=v into both the insertion-ordered map and the sorted index.
+func (m *SortedOrderedMap[K, V]) Store(k K, v V) *SortedOrderedMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.items[k]; ok {
+		return m
+	}
+	item := newItem(k, v, m.keys.PushBack(k), m.OrderedMap)
+	m.items[k] = item
+	m.list.insert(*item)
+	return m
+}
+
+// Delete removes k from both the insertion-ordered map and the sorted index.
+func (m *SortedOrderedMap[K, V]) Delete(k K) *SortedOrderedMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	item, ok := m.items[k]
+	if !ok {
+		return m
+	}
+	m.keys.Remove(item.elements())
+	delete(m.items, k)
+	m.list.delete(k, *item)
+	return m
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores and returns the given value, adding it to both
+// the insertion-ordered map and the sorted index.
+func (m *SortedOrderedMap[K, V]) LoadOrStore(k K, v V) (actual V, loaded bool) {
+	if actual, loaded = m.Load(k); loaded {
+		return actual, loaded
+	}
+	m.Store(k, v)
+	return v, loaded
+}
+
+// Clear empties both the insertion-ordered map and the sorted index.
+func (m *SortedOrderedMap[K, V]) Clear() *SortedOrderedMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys = glist.New[K]()
+	m.items = map[K]*Item[K, V]{}
+	m.list = newSkipList[K, V](m.cmp)
+	return m
+}
+
+// InsertAt inserts k=v at pos in insertion order (see OrderedMap.InsertAt
+// for the exact positional semantics) and adds it to the sorted index.
+func (m *SortedOrderedMap[K, V]) InsertAt(pos int, k K, v V) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.items[k]; ok {
+		return nil
+	}
+
+	n := m.keys.Len()
+	if pos < 0 {
+		pos += n
+	}
+	if pos < 0 || pos > n {
+		return ErrOutOfRange
+	}
+
+	var item *Item[K, V]
+	if pos == n {
+		item = newItem(k, v, m.keys.PushBack(k), m.OrderedMap)
+	} else {
+		mark, err := m.elementAt(pos)
+		if err != nil {
+			return err
+		}
+		item = newItem(k, v, m.keys.InsertBefore(k, mark), m.OrderedMap)
+	}
+	m.items[k] = item
+	m.list.insert(*item)
+	return nil
+}
+
+// UnmarshalJSON decodes data via OrderedMap.UnmarshalJSON and then
+// rebuilds the sorted index from the resulting items.
+func (m *SortedOrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	err := m.OrderedMap.UnmarshalJSON(data)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.list = newSkipList[K, V](m.cmp)
+	for _, item := range m.items {
+		m.list.insert(*item)
+	}
+	return err
+}
+
+// MoveToFront moves k to the front of insertion order; see the doc
+// comment on SortedOrderedMap for why the sorted index needs no update.
+func (m *SortedOrderedMap[K, V]) MoveToFront(k K) { m.OrderedMap.MoveToFront(k) }
+
+// MoveToBack moves k to the back of insertion order; see the doc comment
+// on SortedOrderedMap for why the sorted index needs no update.
+func (m *SortedOrderedMap[K, V]) MoveToBack(k K) { m.OrderedMap.MoveToBack(k) }
+
+// MoveBefore moves k directly before mark in insertion order; see the
+// doc comment on SortedOrderedMap for why the sorted index needs no update.
+func (m *SortedOrderedMap[K, V]) MoveBefore(k, mark K) { m.OrderedMap.MoveBefore(k, mark) }
+
+// MoveAfter moves k directly after mark in insertion order; see the doc
+// comment on SortedOrderedMap for why the sorted index needs no update.
+func (m *SortedOrderedMap[K, V]) MoveAfter(k, mark K) { m.OrderedMap.MoveAfter(k, mark) }
+
+// Min returns the item sorting first under the comparator, or nil if the map is empty.
+func (m *SortedOrderedMap[K, V]) Min() *Item[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.list.min()
+}
+
+// Max returns the item sorting last under the comparator, or nil if the map is empty.
+func (m *SortedOrderedMap[K, V]) Max() *Item[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.list.max()
+}
+
+// Ceiling returns the item with the least order that is >= k under the
+// comparator, or nil if none qualifies.
+func (m *SortedOrderedMap[K, V]) Ceiling(k K) *Item[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.list.ceiling(m.probe(k))
+}
+
+// Floor returns the item with the greatest order that is <= k under the
+// comparator, or nil if none qualifies.
+func (m *SortedOrderedMap[K, V]) Floor(k K) *Item[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.list.floor(m.probe(k))
+}
+
+// RangeByOrder visits every item whose order falls within [lo, hi] under
+// the comparator, ascending, stopping early if visitor returns true.
+func (m *SortedOrderedMap[K, V]) RangeByOrder(lo, hi K, visitor Visitor[K, V]) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	idx := 0
+	m.list.rangeVisit(m.probe(lo), m.probe(hi), func(item Item[K, V]) bool {
+		skip := visitor(idx, item.Key(), item.Value())
+		idx++
+		return skip
+	})
+}
+
+// TravelSorted walks all items in comparator order, honoring mode and
+// filters, mirroring the existing Travel API.
+func (m *SortedOrderedMap[K, V]) TravelSorted(mode TravelMode, f Visitor[K, V], filters ...Filter[K, V]) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var idx int
+	for _, item := range m.list.collect(mode) {
+		idx++
+		drop := false
+		for _, filter := range filters {
+			if drop = !filter(idx-1, item.Key(), item.Value()); drop {
+				break
+			}
+		}
+		if drop {
+			continue
+		}
+		if f(idx-1, item.Key(), item.Value()) {
+			break
+		}
+	}
+}
+
+// probe builds the Item compared against k: the real item if k is
+// present, otherwise a zero-value V. Callers must hold at least a read lock.
+func (m *SortedOrderedMap[K, V]) probe(k K) Item[K, V] {
+	if item, ok := m.items[k]; ok {
+		return *item
+	}
+	var zero V
+	return Item[K, V]{k: k, v: zero}
+}
@@ -0,0 +1,103 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func byValue(a, b Item[string, int]) int {
+	return a.Value() - b.Value()
+}
+
+func TestSortedOrderedMap_MinMax(t *testing.T) {
+	a := assert.New(t)
+	m := NewSorted[string, int](byValue)
+	m.Store("c", 3).Store("a", 1).Store("b", 2)
+
+	a.Equal(1, m.Min().Value())
+	a.Equal(3, m.Max().Value())
+
+	m.Delete("a")
+	a.Equal(2, m.Min().Value())
+
+	// insertion order is unaffected
+	a.Equal([]int{3, 2}, m.Slice())
+}
+
+func TestSortedOrderedMap_CeilingFloor(t *testing.T) {
+	a := assert.New(t)
+	m := NewSorted[string, int](byValue)
+	m.Store("a", 10).Store("b", 20).Store("c", 30)
+
+	a.Equal(20, m.Ceiling("b").Value())
+	a.Equal(20, m.Floor("b").Value())
+	// "missing" isn't in the map, so it probes with a zero value (0),
+	// which sorts before every stored value here.
+	a.Equal(10, m.Ceiling("missing").Value())
+	a.Nil(m.Floor("missing"))
+}
+
+func TestSortedOrderedMap_RangeAndTravel(t *testing.T) {
+	a := assert.New(t)
+	m := NewSorted[string, int](byValue)
+	m.Store("a", 1).Store("b", 2).Store("c", 3).Store("d", 4)
+
+	var got []int
+	m.RangeByOrder("b", "c", func(idx int, k string, v int) bool {
+		got = append(got, v)
+		return false
+	})
+	a.Equal([]int{2, 3}, got)
+
+	got = nil
+	m.TravelSorted(Reverse, func(idx int, k string, v int) bool {
+		got = append(got, v)
+		return false
+	})
+	a.Equal([]int{4, 3, 2, 1}, got)
+}
+
+func TestSortedOrderedMap_ClearResetsIndex(t *testing.T) {
+	a := assert.New(t)
+	m := NewSorted[string, int](byValue)
+	m.Store("a", 1).Store("b", 2)
+
+	m.Clear()
+	a.Equal(0, m.Len())
+	a.Nil(m.Min())
+	a.Nil(m.Max())
+
+	m.Store("c", 3)
+	a.Equal(3, m.Min().Value())
+	a.Equal(3, m.Max().Value())
+}
+
+func TestSortedOrderedMap_LoadOrStoreUpdatesIndex(t *testing.T) {
+	a := assert.New(t)
+	m := NewSorted[string, int](byValue)
+
+	v, loaded := m.LoadOrStore("a", 1)
+	a.False(loaded)
+	a.Equal(1, v)
+	a.Equal(1, m.Min().Value())
+
+	v, loaded = m.LoadOrStore("a", 99)
+	a.True(loaded)
+	a.Equal(1, v)
+	a.Equal(1, m.Min().Value())
+}
+
+func TestSortedOrderedMap_InsertAtUpdatesIndex(t *testing.T) {
+	a := assert.New(t)
+	m := NewSorted[string, int](byValue)
+	m.Store("a", 10).Store("b", 30)
+
+	a.NoError(m.InsertAt(1, "c", 20))
+	a.Equal([]int{10, 20, 30}, m.Slice())
+	a.Equal(10, m.Min().Value())
+	a.Equal(30, m.Max().Value())
+	// "a" probes with its own value (10), so ceiling-of-self returns itself.
+	a.Equal(10, m.Ceiling("a").Value())
+	a.Equal(20, m.Floor("c").Value())
+}
@@ -0,0 +1,183 @@
+package orderedmap
+
+import "sync"
+
+// EventKind identifies the kind of mutation an Event represents.
+type EventKind int
+
+const (
+	EventStore EventKind = iota
+	EventUpdate
+	EventDelete
+	EventClear
+	EventMove
+)
+
+// Event describes one mutation published to a subscriber. Index is the
+// post-mutation position of Key in insertion order; it is -1 for
+// EventDelete and EventClear, where the key no longer has one.
+//
+// EventUpdate is reserved for a future value-replacing mutation: Store's
+// existing semantics only insert when the key is absent and never
+// overwrite an existing value, so no current mutation emits it.
+type Event[K comparable, V any] struct {
+	Kind     EventKind
+	Key      K
+	OldValue V
+	NewValue V
+	Index    int
+}
+
+// DropPolicy controls what Subscribe does when a subscriber's channel is
+// full and a new Event needs to be delivered.
+type DropPolicy int
+
+const (
+	// Block waits for the subscriber to make room, applying backpressure
+	// to the mutating goroutine.
+	Block DropPolicy = iota
+	// DropOldest discards the oldest buffered Event to make room for the new one.
+	DropOldest
+	// DropNewest discards the new Event, leaving the buffer untouched.
+	DropNewest
+)
+
+// SubscribeOptions configures a call to OrderedMap.Subscribe.
+type SubscribeOptions[K comparable, V any] struct {
+	// Filter, if set, restricts delivery to events whose index/key/value
+	// satisfy it. For EventDelete and EventClear, OldValue is passed to
+	// Filter since NewValue is meaningless there.
+	Filter Filter[K, V]
+	// BufferSize is the subscriber channel's capacity. Defaults to 16.
+	BufferSize int
+	// Drop selects the behavior when the channel is full. Defaults to Block.
+	Drop DropPolicy
+}
+
+// subscriber is one Subscribe call's delivery channel and settings.
+type subscriber[K comparable, V any] struct {
+	ch     chan Event[K, V]
+	filter Filter[K, V]
+	drop   DropPolicy
+
+	done     chan struct{}
+	inflight sync.WaitGroup
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// Subscribe registers to receive Events for mutations made after this
+// call returns, matching opts.Filter if one is set. Events are published
+// after the write lock for the mutation is released, so a subscriber may
+// safely call back into the map without deadlocking. The returned func
+// unsubscribes and closes the channel; it is safe to call more than once.
+func (m *OrderedMap[K, V]) Subscribe(opts SubscribeOptions[K, V]) (<-chan Event[K, V], func()) {
+	bufSize := opts.BufferSize
+	if bufSize <= 0 {
+		bufSize = 16
+	}
+	sub := &subscriber[K, V]{
+		ch:     make(chan Event[K, V], bufSize),
+		filter: opts.Filter,
+		drop:   opts.Drop,
+		done:   make(chan struct{}),
+	}
+
+	m.subMu.Lock()
+	if m.subs == nil {
+		m.subs = map[*subscriber[K, V]]struct{}{}
+	}
+	m.subs[sub] = struct{}{}
+	m.subMu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			m.subMu.Lock()
+			delete(m.subs, sub)
+			m.subMu.Unlock()
+
+			sub.mu.Lock()
+			sub.closed = true
+			sub.mu.Unlock()
+			close(sub.done)
+
+			// Wait for any send already past the closed check to either
+			// deliver or abort via sub.done, so closing ch below can never
+			// race with a send on it.
+			sub.inflight.Wait()
+			close(sub.ch)
+		})
+	}
+	return sub.ch, unsubscribe
+}
+
+// publish delivers ev to every current subscriber whose Filter (if any)
+// accepts it. Callers must not hold m.mu, since Block subscribers may
+// stall the caller until a slow consumer drains its channel.
+func (m *OrderedMap[K, V]) publish(ev Event[K, V]) {
+	m.subMu.Lock()
+	if len(m.subs) == 0 {
+		m.subMu.Unlock()
+		return
+	}
+	subs := make([]*subscriber[K, V], 0, len(m.subs))
+	for s := range m.subs {
+		subs = append(subs, s)
+	}
+	m.subMu.Unlock()
+
+	filterVal := ev.NewValue
+	if ev.Kind == EventDelete || ev.Kind == EventClear {
+		filterVal = ev.OldValue
+	}
+
+	for _, s := range subs {
+		if s.filter != nil && !s.filter(ev.Index, ev.Key, filterVal) {
+			continue
+		}
+		s.send(ev)
+	}
+}
+
+// send delivers ev honoring the subscriber's DropPolicy. The Block case's
+// channel send happens outside s.mu, selecting against s.done, so a slow
+// or dead consumer can never block unsubscribe from acquiring s.mu and
+// completing.
+func (s *subscriber[K, V]) send(ev Event[K, V]) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.inflight.Add(1)
+	s.mu.Unlock()
+	defer s.inflight.Done()
+
+	switch s.drop {
+	case DropOldest:
+		select {
+		case s.ch <- ev:
+		default:
+			select {
+			case <-s.ch:
+			default:
+			}
+			select {
+			case s.ch <- ev:
+			default:
+			}
+		}
+	case DropNewest:
+		select {
+		case s.ch <- ev:
+		default:
+		}
+	default: // Block
+		select {
+		case s.ch <- ev:
+		case <-s.done:
+		}
+	}
+}
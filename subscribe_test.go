@@ -0,0 +1,170 @@
+package orderedmap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap_Subscribe(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+
+	ch, unsubscribe := m.Subscribe(SubscribeOptions[string, int]{BufferSize: 4})
+	defer unsubscribe()
+
+	m.Store("a", 1)
+	m.Delete("a")
+	m.Store("b", 2)
+	m.Clear()
+
+	want := []EventKind{EventStore, EventDelete, EventStore, EventClear}
+	for i, k := range want {
+		select {
+		case ev := <-ch:
+			a.Equal(k, ev.Kind, "event %d", i)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for event %d", i)
+		}
+	}
+}
+
+func TestOrderedMap_SubscribeFilter(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+
+	onlyEven := func(idx int, k string, v int) bool { return v%2 == 0 }
+	ch, unsubscribe := m.Subscribe(SubscribeOptions[string, int]{Filter: onlyEven, BufferSize: 4})
+	defer unsubscribe()
+
+	m.Store("a", 1)
+	m.Store("b", 2)
+
+	select {
+	case ev := <-ch:
+		a.Equal("b", ev.Key)
+		a.Equal(2, ev.NewValue)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for filtered event")
+	}
+
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected second event: %+v", ev)
+	default:
+	}
+}
+
+func TestOrderedMap_SubscribeDropNewest(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+
+	ch, unsubscribe := m.Subscribe(SubscribeOptions[string, int]{BufferSize: 1, Drop: DropNewest})
+	defer unsubscribe()
+
+	m.Store("a", 1)
+	m.Store("b", 2) // buffer already full, dropped
+
+	ev := <-ch
+	a.Equal("a", ev.Key)
+
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected event after drop: %+v", ev)
+	default:
+	}
+}
+
+func TestOrderedMap_SubscribeBlock(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+
+	// Default Drop is Block.
+	ch, unsubscribe := m.Subscribe(SubscribeOptions[string, int]{BufferSize: 1})
+	defer unsubscribe()
+
+	m.Store("a", 1) // fills the buffer
+
+	done := make(chan struct{})
+	go func() {
+		m.Store("b", 2) // blocks in send() until "a" is drained
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Store returned before the blocked event was drained")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	ev := <-ch // drain "a", unblocking the goroutine above
+	a.Equal("a", ev.Key)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("blocked Store never returned after buffer drained")
+	}
+
+	ev = <-ch
+	a.Equal("b", ev.Key)
+}
+
+// TestOrderedMap_UnsubscribeDuringBlockedSend guards against the deadlock
+// where a Block subscriber's full buffer and a dead consumer would
+// otherwise wedge both the mutating goroutine and unsubscribe forever.
+func TestOrderedMap_UnsubscribeDuringBlockedSend(t *testing.T) {
+	m := New[string, int]()
+
+	ch, unsubscribe := m.Subscribe(SubscribeOptions[string, int]{BufferSize: 1})
+	m.Store("a", 1) // fills the buffer; consumer never drains it
+
+	storeDone := make(chan struct{})
+	go func() {
+		m.Store("b", 2) // blocks in send()
+		close(storeDone)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	unsubscribeDone := make(chan struct{})
+	go func() {
+		unsubscribe()
+		close(unsubscribeDone)
+	}()
+
+	select {
+	case <-unsubscribeDone:
+	case <-time.After(time.Second):
+		t.Fatal("unsubscribe deadlocked behind a blocked send")
+	}
+
+	select {
+	case <-storeDone:
+	case <-time.After(time.Second):
+		t.Fatal("blocked Store never returned after unsubscribe")
+	}
+
+	// ch still holds "a" buffered from before unsubscribe ("b" was abandoned
+	// via sub.done, since the buffer never had room); drain it before
+	// expecting the closed-channel signal.
+	var drained []string
+	for ev := range ch {
+		drained = append(drained, ev.Key)
+	}
+	a := assert.New(t)
+	a.NotEmpty(drained)
+}
+
+func TestOrderedMap_Unsubscribe(t *testing.T) {
+	a := assert.New(t)
+	m := New[string, int]()
+
+	ch, unsubscribe := m.Subscribe(SubscribeOptions[string, int]{})
+	unsubscribe()
+	unsubscribe() // must be safe to call twice
+
+	m.Store("a", 1)
+	_, ok := <-ch
+	a.False(ok, "channel should be closed after unsubscribe")
+}